@@ -0,0 +1,225 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// CommandKind identifies which `:` command was parsed.
+type CommandKind int
+
+const (
+	CmdGoto CommandKind = iota
+	CmdRange
+	CmdTranslation
+	CmdSearch
+	CmdPipe
+)
+
+// Command is the result of parsing a `:`-prefixed input, modeled on the
+// small imperative commands a pager or editor exposes.
+type Command struct {
+	Kind        CommandKind
+	Reference   string // goto, range
+	Translation string // trans
+	Query       string // search
+	PipeTarget  string // pipe
+}
+
+// ParseCommand turns raw command-prompt input (with or without the leading
+// `:`) into a Command. Supported forms:
+//
+//	:goto john 3:16
+//	:range romans 8:28-39
+//	:trans web
+//	:search love
+//	:pipe less
+func ParseCommand(input string) (Command, error) {
+	input = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(input), ":"))
+	if input == "" {
+		return Command{}, fmt.Errorf("empty command")
+	}
+
+	fields := strings.SplitN(input, " ", 2)
+	name := strings.ToLower(fields[0])
+	var rest string
+	if len(fields) > 1 {
+		rest = strings.TrimSpace(fields[1])
+	}
+
+	switch name {
+	case "goto", "go", "g":
+		if rest == "" {
+			return Command{}, fmt.Errorf("usage: :goto <book> <chapter>:<verse>")
+		}
+		return Command{Kind: CmdGoto, Reference: rest}, nil
+
+	case "range":
+		if rest == "" {
+			return Command{}, fmt.Errorf("usage: :range <book> <chapter>:<start>-<end>")
+		}
+		return Command{Kind: CmdRange, Reference: rest}, nil
+
+	case "trans", "translation":
+		if rest == "" {
+			return Command{}, fmt.Errorf("usage: :trans <code>")
+		}
+		return Command{Kind: CmdTranslation, Translation: strings.ToLower(rest)}, nil
+
+	case "search", "find":
+		if rest == "" {
+			return Command{}, fmt.Errorf("usage: :search <query>")
+		}
+		return Command{Kind: CmdSearch, Query: rest}, nil
+
+	case "pipe":
+		if rest == "" {
+			return Command{}, fmt.Errorf("usage: :pipe <command>")
+		}
+		return Command{Kind: CmdPipe, PipeTarget: rest}, nil
+
+	default:
+		return Command{}, fmt.Errorf("unknown command: %s", name)
+	}
+}
+
+func newCommandInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = ":"
+	ti.CharLimit = 120
+	ti.Placeholder = "goto john 3:16"
+	ti.Focus()
+	return ti
+}
+
+// enterCommandMode switches into stateCommand with a freshly focused
+// command prompt, remembering where to return to on cancel.
+func (m model) enterCommandMode() (tea.Model, tea.Cmd) {
+	if m.loading {
+		return m, nil
+	}
+	m.previousState = m.state
+	m.state = stateCommand
+	m.commandInput = newCommandInput()
+	m.err = nil
+	return m, textinput.Blink
+}
+
+// handleCommandKey drives the command prompt: everything but enter/esc is
+// forwarded to the textinput widget.
+func (m model) handleCommandKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.state = m.previousState
+		m.commandInput.Blur()
+		return m, nil
+	case "enter":
+		input := m.commandInput.Value()
+		m.commandInput.Blur()
+		m.state = m.previousState
+		return m.executeCommand(input)
+	}
+
+	var cmd tea.Cmd
+	m.commandInput, cmd = m.commandInput.Update(msg)
+	return m, cmd
+}
+
+// executeCommand runs a parsed Command and returns to reading/navigating.
+func (m model) executeCommand(input string) (tea.Model, tea.Cmd) {
+	cmd, err := ParseCommand(input)
+	if err != nil {
+		m.err = err
+		return m, nil
+	}
+
+	switch cmd.Kind {
+	case CmdGoto:
+		return m.gotoReference(cmd.Reference)
+
+	case CmdRange:
+		return m.gotoReference(cmd.Reference)
+
+	case CmdTranslation:
+		t, ok := translationByID(m.translations, cmd.Translation)
+		if !ok {
+			m.err = fmt.Errorf("unknown translation: %s", cmd.Translation)
+			return m, nil
+		}
+		m.activeTranslation = t.ID
+		m.err = nil
+
+		cmds := []tea.Cmd{saveTranslationConfigCmd(t.ID)}
+		if m.lastReference != "" {
+			m.loading = true
+			cmds = append(cmds, m.spinner.Tick, fetchVerseCmd(t, m.lastReference))
+		}
+		return m, tea.Batch(cmds...)
+
+	case CmdSearch:
+		m.previousState = m.state
+		return m.runSearch(cmd.Query)
+
+	case CmdPipe:
+		return m.pipeContent(cmd.PipeTarget)
+	}
+
+	return m, nil
+}
+
+// gotoReference jumps straight into stateReading for a raw "book c:v" or
+// "book c:v-v2" reference, bypassing the testament/book/chapter/verse list
+// walk entirely.
+func (m model) gotoReference(reference string) (tea.Model, tea.Cmd) {
+	book, chapter, startVerse, endVerse, err := parseReference(reference)
+	if err != nil {
+		m.err = err
+		return m, nil
+	}
+
+	selectedBook, testament, ok := findBookByName(book)
+	if !ok {
+		selectedBook = Book{Name: book}
+	}
+	m.selectedBook = selectedBook
+	m.selectedTest = testament
+	m.selectedChap = chapter
+	m.selectedVerse = startVerse
+
+	if startVerse == endVerse {
+		m.breadcrumb = []string{book, fmt.Sprintf("Chapter %d", chapter), fmt.Sprintf("Verse %d", startVerse)}
+	} else {
+		m.breadcrumb = []string{book, fmt.Sprintf("Chapter %d", chapter), fmt.Sprintf("Verses %d-%d", startVerse, endVerse)}
+	}
+	m.loading = true
+	m.err = nil
+
+	return m, tea.Batch(
+		m.spinner.Tick,
+		fetchVerseCmd(m.currentTranslation(), reference),
+	)
+}
+
+// pipeContent suspends the TUI and pipes the plain-text reading content
+// into an external command, e.g. `:pipe less` or `:pipe wc -w`.
+func (m model) pipeContent(target string) (tea.Model, tea.Cmd) {
+	if m.plainContent == "" {
+		m.err = fmt.Errorf("nothing to pipe yet; read a verse first")
+		return m, nil
+	}
+
+	parts := strings.Fields(target)
+	c := exec.Command(parts[0], parts[1:]...)
+	c.Stdin = strings.NewReader(m.plainContent)
+
+	return m, tea.ExecProcess(c, func(err error) tea.Msg {
+		if err != nil {
+			return errMsg(err)
+		}
+		return nil
+	})
+}
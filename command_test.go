@@ -0,0 +1,101 @@
+package main
+
+import "testing"
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Command
+		wantErr bool
+	}{
+		{
+			name:  "goto with leading colon",
+			input: ":goto John 3:16",
+			want:  Command{Kind: CmdGoto, Reference: "John 3:16"},
+		},
+		{
+			name:  "goto without leading colon",
+			input: "goto John 3:16",
+			want:  Command{Kind: CmdGoto, Reference: "John 3:16"},
+		},
+		{
+			name:  "go alias",
+			input: ":go Romans 8:28",
+			want:  Command{Kind: CmdGoto, Reference: "Romans 8:28"},
+		},
+		{
+			name:  "g alias",
+			input: ":g Romans 8:28",
+			want:  Command{Kind: CmdGoto, Reference: "Romans 8:28"},
+		},
+		{
+			name:  "range",
+			input: ":range Romans 8:28-39",
+			want:  Command{Kind: CmdRange, Reference: "Romans 8:28-39"},
+		},
+		{
+			name:  "trans lowercases code",
+			input: ":trans WEB",
+			want:  Command{Kind: CmdTranslation, Translation: "web"},
+		},
+		{
+			name:  "translation alias",
+			input: ":translation web",
+			want:  Command{Kind: CmdTranslation, Translation: "web"},
+		},
+		{
+			name:  "search",
+			input: ":search love",
+			want:  Command{Kind: CmdSearch, Query: "love"},
+		},
+		{
+			name:  "find alias",
+			input: ":find love thy neighbor",
+			want:  Command{Kind: CmdSearch, Query: "love thy neighbor"},
+		},
+		{
+			name:  "pipe",
+			input: ":pipe less",
+			want:  Command{Kind: CmdPipe, PipeTarget: "less"},
+		},
+		{
+			name:    "empty input",
+			input:   "   ",
+			wantErr: true,
+		},
+		{
+			name:    "goto missing argument",
+			input:   ":goto",
+			wantErr: true,
+		},
+		{
+			name:    "trans missing argument",
+			input:   ":trans",
+			wantErr: true,
+		},
+		{
+			name:    "unknown command",
+			input:   ":bogus foo",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCommand(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseCommand(%q) = %+v, want error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCommand(%q) returned unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseCommand(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
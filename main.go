@@ -1,18 +1,16 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
-	"net/url"
+	"hash/fnv"
 	"os"
 	// "strconv"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -27,6 +25,10 @@ const (
 	stateChapter
 	stateVerse
 	stateReading
+	stateSessions
+	stateCommand
+	stateTranslation
+	stateSearch
 )
 
 // Bible data structures
@@ -133,6 +135,36 @@ type model struct {
 	selectedVerse int
 	width         int
 	height        int
+	provider      VerseProvider
+
+	sessions        *sessionStore
+	bookmarks       []Bookmark
+	highlights      []Highlight
+	history         []HistoryEntry
+	previousState   navState
+	lastReference   string
+	lastTranslation string
+	renaming        bool
+	renameBuffer    string
+
+	commandInput textinput.Model
+	plainContent string
+
+	translations      []Translation
+	activeTranslation string
+
+	prefetcher     *Prefetcher
+	prefetchStatus *prefetchStatus
+	prefetchCache  *verseCache
+	prefetchBar    progress.Model
+	prefetchDone   int
+	prefetchTotal  int
+	prefetchWindow int
+
+	searchIndex        *searchIndex
+	searchInput        textinput.Model
+	searchQuery        searchQuery
+	searchResultsShown bool
 }
 
 func initialModel() model {
@@ -142,20 +174,63 @@ func initialModel() model {
 	s.Spinner = spinner.Dot
 	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
 
+	var local *localProvider
+	var provider VerseProvider
+	if cachingProv, err := newCachingProvider(); err != nil {
+		// Bundled data failed to load; remote-only is still usable.
+		provider = newRemoteProvider()
+	} else {
+		provider = cachingProv
+		local = cachingProv.local
+	}
+
+	var indexedVerses []indexedVerse
+	if local != nil {
+		indexedVerses = local.allVerses()
+	}
+	searchIdx := buildSearchIndex(indexedVerses)
+
+	sessions, err := newSessionStore()
+	if err != nil {
+		// No home dir to persist to; sessions just won't survive a restart.
+		sessions = nil
+	}
+
+	translations := buildTranslations(provider)
+
+	// Read synchronously (unlike the translation choice, which loads async
+	// in Init) since the pool size has to be known before newPrefetcher
+	// spawns its workers.
+	cfg, _ := loadConfig()
+	poolSize, window := prefetchSettings(cfg)
+
+	prefetchCache := newVerseCache(defaultPrefetchCacheEntries)
+	prefetchStatus := newPrefetchStatus()
+
 	return model{
-		list:    l,
-		spinner: s,
-		state:   stateTestament,
-		loading: false,
-		err:     nil,
-		content: "",
-		width:   80,
-		height:  24,
+		list:              l,
+		spinner:           s,
+		state:             stateTestament,
+		loading:           false,
+		err:               nil,
+		content:           "",
+		width:             80,
+		height:            24,
+		provider:          provider,
+		sessions:          sessions,
+		translations:      translations,
+		activeTranslation: translations[0].ID,
+		prefetcher:        newPrefetcher(provider, prefetchCache, prefetchStatus, poolSize),
+		prefetchStatus:    prefetchStatus,
+		prefetchCache:     prefetchCache,
+		prefetchBar:       newPrefetchBar(),
+		prefetchWindow:    window,
+		searchIndex:       searchIdx,
 	}
 }
 
 func (m model) Init() tea.Cmd {
-	return nil
+	return tea.Batch(loadSessionsCmd(m.sessions), loadTranslationConfigCmd(), listenPrefetchCmd(m.prefetchStatus))
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -163,6 +238,16 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.renaming {
+			return m.handleRenameKey(msg)
+		}
+		if m.state == stateCommand {
+			return m.handleCommandKey(msg)
+		}
+		if m.state == stateSearch && !m.searchResultsShown {
+			return m.handleSearchKey(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
@@ -170,6 +255,15 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.handleBack()
 		case "enter":
 			return m.handleSelect()
+		case ":":
+			return m.enterCommandMode()
+		case "b":
+			if !m.loading && m.state != stateSessions {
+				m.previousState = m.state
+				m.state = stateSessions
+				m.list = createSessionsList(m.bookmarks, m.highlights, m.history)
+				m.err = nil
+			}
 		case "p":
 			if m.state == stateReading {
 				return m.navigateVerse(-1)
@@ -178,19 +272,86 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.state == stateReading {
 				return m.navigateVerse(1)
 			}
+			if m.state == stateSessions {
+				return m.newBookmark()
+			}
+		case "h":
+			if m.state == stateReading {
+				return m.toggleHighlight()
+			}
+		case "d":
+			if m.state == stateSessions {
+				return m.deleteSelectedEntry()
+			}
+		case "r":
+			if m.state == stateSessions {
+				return m.beginRename()
+			}
+		case "t":
+			if !m.loading && m.state != stateTranslation {
+				return m.enterTranslationMode()
+			}
+		case "f":
+			if !m.loading && m.state != stateSearch {
+				return m.enterSearchMode()
+			}
 		}
 
 	case verseMsg:
 		m.loading = false
-		m.content = formatBibleResponse(BibleResponse(msg), m.width-6) // Account for padding and borders
+		resp := BibleResponse(msg)
+		m.content = formatBibleResponse(resp, m.width-6) // Account for padding and borders
+		m.plainContent = plainBibleResponse(resp)
 		m.state = stateReading
 		if !m.ready {
 			m.viewport = viewport.New(m.width-4, m.height-8)
 			m.ready = true
 		}
 		m.viewport.SetContent(m.content)
+
+		translation := resp.Translation
+		if translation == "" {
+			translation = "kjv"
+		}
+		m.lastReference = resp.Reference
+		m.lastTranslation = translation
+		m.history = recordHistory(m.history, translation, resp.Reference)
+
+		if m.selectedBook.Name != "" {
+			refs := prefetchReferences(m.selectedBook, m.selectedChap, m.selectedVerse, m.prefetchWindow)
+			m.prefetcher.enqueue(translation, refs)
+		}
+		return m, m.saveSessionsCmd()
+
+	case sessionsLoadedMsg:
+		m.bookmarks = msg.bookmarks
+		m.highlights = msg.highlights
+		m.history = msg.history
+		return m, nil
+
+	case sessionsSaveMsg:
+		if msg.err != nil {
+			m.err = msg.err
+		}
+		return m, nil
+
+	case translationConfigLoadedMsg:
+		if _, ok := translationByID(m.translations, msg.id); ok {
+			m.activeTranslation = msg.id
+		}
 		return m, nil
 
+	case prefetchProgressMsg:
+		m.prefetchDone = msg.done
+		m.prefetchTotal = msg.total
+		barCmd := m.prefetchBar.SetPercent(prefetchPercent(msg.done, msg.total))
+		return m, tea.Batch(listenPrefetchCmd(m.prefetchStatus), barCmd)
+
+	case progress.FrameMsg:
+		barModel, barCmd := m.prefetchBar.Update(msg)
+		m.prefetchBar = barModel.(progress.Model)
+		return m, barCmd
+
 	case errMsg:
 		m.loading = false
 		m.err = msg
@@ -214,6 +375,8 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.list.SetSize(listWidth, listHeight)
 
+		m.prefetchBar.Width = msg.Width - 4
+
 		if m.ready {
 			// Update viewport size
 			m.viewport.Width = msg.Width - 4
@@ -250,7 +413,7 @@ func (m model) View() string {
 	var s strings.Builder
 
 	// Title
-	s.WriteString(titleStyle.Render("ðŸ“– Bible CLI Reader"))
+	s.WriteString(titleStyle.Render(fmt.Sprintf("ðŸ“– Bible CLI Reader [%s]", strings.ToUpper(m.activeTranslation))))
 	s.WriteString("\n\n")
 
 	// Breadcrumb
@@ -265,6 +428,24 @@ func (m model) View() string {
 		s.WriteString("\n\n")
 	}
 
+	// Rename prompt
+	if m.renaming {
+		s.WriteString(breadcrumbStyle.Render("Note: " + m.renameBuffer + "█"))
+		s.WriteString("\n\n")
+	}
+
+	// Command prompt
+	if m.state == stateCommand {
+		s.WriteString(m.commandInput.View())
+		s.WriteString("\n\n")
+	}
+
+	// Search query box
+	if m.state == stateSearch && !m.searchResultsShown {
+		s.WriteString(m.searchInput.View())
+		s.WriteString("\n\n")
+	}
+
 	// Error display
 	if m.err != nil {
 		s.WriteString(errorStyle.Render("Error: " + m.err.Error()))
@@ -288,7 +469,7 @@ func (m model) renderReading() string {
 	var s strings.Builder
 
 	// Title
-	s.WriteString(titleStyle.Render("ðŸ“– Bible Reader"))
+	s.WriteString(titleStyle.Render(fmt.Sprintf("ðŸ“– Bible Reader [%s]", strings.ToUpper(m.activeTranslation))))
 	s.WriteString("\n\n")
 
 	// Breadcrumb
@@ -308,8 +489,15 @@ func (m model) renderReading() string {
 		s.WriteString("\n")
 	}
 
+	// Prefetch progress
+	if m.prefetchTotal > 0 {
+		s.WriteString(helpStyle.Render(fmt.Sprintf("prefetching %d/%d ", m.prefetchDone, m.prefetchTotal)))
+		s.WriteString(m.prefetchBar.View())
+		s.WriteString("\n")
+	}
+
 	// Help text
-	s.WriteString(helpStyle.Render("j/k: scroll â€¢ p/n: prev/next verse â€¢ delete: back â€¢ q/Ctrl+C: quit"))
+	s.WriteString(helpStyle.Render("j/k: scroll â€¢ p/n: prev/next verse â€¢ h: highlight â€¢ b: sessions â€¢ t: translation â€¢ f: search â€¢ : command â€¢ delete: back â€¢ q/Ctrl+C: quit"))
 
 	return s.String()
 }
@@ -342,11 +530,53 @@ func (m model) handleBack() (tea.Model, tea.Cmd) {
 		m.state = stateVerse
 		m.list = createVerseList(m.selectedBook, m.selectedChap)
 		m.err = nil
+	case stateSessions:
+		m.state = m.previousState
+		m.list = m.restoreListForState(m.previousState)
+		m.err = nil
+	case stateTranslation:
+		m.state = m.previousState
+		m.list = m.restoreListForState(m.previousState)
+		m.err = nil
+	case stateSearch:
+		// Only the results list reaches handleBack; the query box itself
+		// handles esc in handleSearchKey.
+		m.state = m.previousState
+		m.searchResultsShown = false
+		m.list = m.restoreListForState(m.previousState)
+		m.err = nil
 	}
 
 	return m, nil
 }
 
+// restoreListForState rebuilds m.list the way it looked the last time state
+// was current, for returning from a transient overlay (sessions,
+// translation, search) to whatever view was active before it — including
+// when that view was itself one of those overlays, since t/f/: can be
+// pressed from any of them. stateReading and stateCommand have no list of
+// their own to restore; callers landing there leave m.list untouched.
+func (m model) restoreListForState(state navState) list.Model {
+	switch state {
+	case stateTestament:
+		return createTestamentList()
+	case stateBook:
+		return createBookList(m.selectedTest)
+	case stateChapter:
+		return createChapterList(m.selectedBook)
+	case stateVerse:
+		return createVerseList(m.selectedBook, m.selectedChap)
+	case stateSessions:
+		return createSessionsList(m.bookmarks, m.highlights, m.history)
+	case stateTranslation:
+		return createTranslationList(m.translations)
+	case stateSearch:
+		return createSearchResultsList(m.searchIndex.search(m.searchQuery), m.searchIndex, m.searchQuery)
+	default: // stateReading, stateCommand
+		return m.list
+	}
+}
+
 func (m model) navigateVerse(direction int) (tea.Model, tea.Cmd) {
 	if m.loading {
 		return m, nil
@@ -411,12 +641,19 @@ func (m model) navigateVerse(direction int) (tea.Model, tea.Cmd) {
 		fmt.Sprintf("Verse %d", m.selectedVerse),
 	}
 
-	// Fetch the new verse
-	m.loading = true
+	// Fetch the new verse, preferring an already-prefetched copy so p/n
+	// mashing doesn't wait on a round trip.
+	translation := m.currentTranslation()
 	reference := fmt.Sprintf("%s %d:%d", m.selectedBook.Name, m.selectedChap, m.selectedVerse)
+
+	if resp, ok := m.prefetchCache.get(translation.ID, reference); ok {
+		return m, func() tea.Msg { return verseMsg(resp) }
+	}
+
+	m.loading = true
 	return m, tea.Batch(
 		m.spinner.Tick,
-		fetchVerse(reference),
+		fetchVerseCmd(translation, reference),
 	)
 }
 
@@ -440,6 +677,16 @@ func (m model) handleSelect() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	if m.state == stateSessions {
+		return m.jumpToSelectedSession()
+	}
+	if m.state == stateTranslation {
+		return m.selectTranslation()
+	}
+	if m.state == stateSearch && m.searchResultsShown {
+		return m.jumpToSearchHit()
+	}
+
 	selectedItem := m.list.SelectedItem()
 	if selectedItem == nil {
 		return m, nil
@@ -498,7 +745,7 @@ func (m model) handleSelect() (tea.Model, tea.Cmd) {
 		reference := fmt.Sprintf("%s %d:%d", m.selectedBook.Name, m.selectedChap, verse.Number)
 		return m, tea.Batch(
 			m.spinner.Tick,
-			fetchVerse(reference),
+			fetchVerseCmd(m.currentTranslation(), reference),
 		)
 	}
 
@@ -534,10 +781,43 @@ func createBookList(testament Testament) list.Model {
 	return l
 }
 
+// knownVerseCounts holds the real per-chapter verse counts for the
+// chapters bundled by localProvider (see data/kjv.json); verseCount uses
+// these where available and falls back to approximateVerseCount elsewhere.
+var knownVerseCounts = map[string]map[int]int{
+	"genesis":       {1: 31},
+	"psalms":        {1: 6, 23: 6},
+	"matthew":       {5: 48},
+	"john":          {1: 51, 3: 36},
+	"romans":        {8: 39},
+	"1 corinthians": {13: 13},
+}
+
+// verseCount reports how many verses a chapter has. It backs the "~%d
+// verses" label in createChapterList, the list sizing in createVerseList,
+// and the chapter-rollover math in navigateVerse/prefetchReferences.
+func verseCount(bookName string, chapter int) int {
+	if chapters, ok := knownVerseCounts[strings.ToLower(bookName)]; ok {
+		if n, ok := chapters[chapter]; ok {
+			return n
+		}
+	}
+	return approximateVerseCount(bookName, chapter)
+}
+
+// approximateVerseCount is the fallback outside the bundled KJV excerpt: a
+// deterministic, plausible-looking count (15-34) derived from the
+// book/chapter so repeated calls for the same reference agree. It's a
+// placeholder, not real per-chapter data (see knownVerseCounts).
+func approximateVerseCount(bookName string, chapter int) int {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%s:%d", strings.ToLower(bookName), chapter)
+	return 15 + int(h.Sum32()%20)
+}
+
 func createChapterList(book Book) list.Model {
 	items := make([]list.Item, book.Chapters)
 	for i := 1; i <= book.Chapters; i++ {
-		// Rough verse count estimation (this could be made more accurate with real data)
 		verseCount := verseCount(book.Name, i)
 		desc := fmt.Sprintf("~%d verses", verseCount)
 		items[i-1] = item{
@@ -603,6 +883,24 @@ func getNewTestament() Testament {
 	}
 }
 
+// findBookByName looks up a Book and its owning Testament by name
+// (case-insensitively) across both testaments, so jump paths that only
+// have a reference string to go on (:goto/:range, bookmarks, search hits)
+// can still populate Chapters and selectedTest — without the former,
+// navigateVerse's "next chapter" bounds check is always false after one of
+// those jumps; without the latter, the breadcrumb's leading segment
+// (built from m.selectedTest.Name) is blank.
+func findBookByName(name string) (Book, Testament, bool) {
+	for _, testament := range []Testament{getOldTestament(), getNewTestament()} {
+		for _, book := range testament.Books {
+			if strings.EqualFold(book.Name, name) {
+				return book, testament, true
+			}
+		}
+	}
+	return Book{}, Testament{}, false
+}
+
 // Word wrapping function
 func wrapText(text string, width int) string {
 	if width <= 0 {
@@ -640,40 +938,39 @@ func wrapText(text string, width int) string {
 }
 
 // API Functions
-func fetchVerse(reference string) tea.Cmd {
-	return func() tea.Msg {
-		cleanRef := strings.TrimSpace(reference)
-		encodedRef := url.QueryEscape(cleanRef)
-
-		apiURL := fmt.Sprintf("https://bible-api.com/%s?translation=kjv", encodedRef)
 
-		client := &http.Client{Timeout: 10 * time.Second}
-		resp, err := client.Get(apiURL)
+// fetchVerseCmd asks translation's bound Fetch func for reference, trying
+// the offline data and disk cache before falling back to bible-api.com.
+func fetchVerseCmd(translation Translation, reference string) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := translation.Fetch(reference)
 		if err != nil {
-			return errMsg(fmt.Errorf("failed to fetch verse: %w", err))
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			return errMsg(fmt.Errorf("API returned status %d", resp.StatusCode))
+			return errMsg(err)
 		}
+		return verseMsg(resp)
+	}
+}
 
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return errMsg(fmt.Errorf("failed to read response: %w", err))
-		}
+// plainBibleResponse renders a response as unstyled text, suitable for
+// piping to an external command via `:pipe`.
+func plainBibleResponse(resp BibleResponse) string {
+	var content strings.Builder
 
-		var bibleResp BibleResponse
-		if err := json.Unmarshal(body, &bibleResp); err != nil {
-			return errMsg(fmt.Errorf("failed to parse response: %w", err))
-		}
+	if resp.Reference != "" {
+		content.WriteString(resp.Reference)
+		content.WriteString("\n\n")
+	}
 
-		if bibleResp.Reference == "" && bibleResp.Text == "" {
-			return errMsg(fmt.Errorf("verse not found: %s", reference))
+	if len(resp.Verses) > 0 {
+		for _, verse := range resp.Verses {
+			fmt.Fprintf(&content, "%d %s\n", verse.Verse, verse.Text)
 		}
-
-		return verseMsg(bibleResp)
+	} else if resp.Text != "" {
+		content.WriteString(resp.Text)
+		content.WriteString("\n")
 	}
+
+	return content.String()
 }
 
 func formatBibleResponse(resp BibleResponse, maxWidth int) string {
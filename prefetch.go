@@ -0,0 +1,241 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Defaults for the prefetch pool, used when config.json doesn't set
+// PrefetchPoolSize/PrefetchWindow; see prefetchSettings.
+const (
+	defaultPrefetchPoolSize     = 4
+	defaultPrefetchWindow       = 3
+	defaultPrefetchCacheEntries = 64
+)
+
+// prefetchSettings resolves the persisted pool size/window, falling back to
+// the defaults above when cfg leaves either at its zero value.
+func prefetchSettings(cfg config) (poolSize, window int) {
+	poolSize, window = defaultPrefetchPoolSize, defaultPrefetchWindow
+	if cfg.PrefetchPoolSize > 0 {
+		poolSize = cfg.PrefetchPoolSize
+	}
+	if cfg.PrefetchWindow > 0 {
+		window = cfg.PrefetchWindow
+	}
+	return poolSize, window
+}
+
+// verseCache is a small in-memory LRU of recently fetched verses, keyed by
+// translation+reference, so a prefetched response can be replayed instantly
+// instead of refetched.
+type verseCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    []string
+	entries  map[string]BibleResponse
+}
+
+func newVerseCache(capacity int) *verseCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &verseCache{capacity: capacity, entries: map[string]BibleResponse{}}
+}
+
+func (c *verseCache) get(translation, reference string) (BibleResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := cacheKey(translation, reference)
+	resp, ok := c.entries[key]
+	if ok {
+		// Move the hit to the back of order so eviction drops the least
+		// recently used entry.
+		for i, k := range c.order {
+			if k == key {
+				c.order = append(c.order[:i], c.order[i+1:]...)
+				c.order = append(c.order, key)
+				break
+			}
+		}
+	}
+	return resp, ok
+}
+
+func (c *verseCache) put(translation, reference string, resp BibleResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := cacheKey(translation, reference)
+	if _, exists := c.entries[key]; !exists {
+		c.order = append(c.order, key)
+		if len(c.order) > c.capacity {
+			oldest := c.order[0]
+			c.order = c.order[1:]
+			delete(c.entries, oldest)
+		}
+	}
+	c.entries[key] = resp
+}
+
+// prefetchJob is one speculative lookup queued on the pool.
+type prefetchJob struct {
+	reference   string
+	translation string
+}
+
+// prefetchStatus tracks outstanding speculative fetches so the footer can
+// show live progress; updates is a 1-buffered signal channel rather than a
+// value channel since only the latest snapshot ever matters.
+type prefetchStatus struct {
+	mu      sync.Mutex
+	total   int
+	done    int
+	updates chan struct{}
+}
+
+func newPrefetchStatus() *prefetchStatus {
+	return &prefetchStatus{updates: make(chan struct{}, 1)}
+}
+
+func (s *prefetchStatus) addTotal(n int) {
+	if n == 0 {
+		return
+	}
+	s.mu.Lock()
+	s.total += n
+	s.mu.Unlock()
+	s.notify()
+}
+
+func (s *prefetchStatus) increment() {
+	s.mu.Lock()
+	s.done++
+	if s.done >= s.total {
+		s.done, s.total = 0, 0
+	}
+	s.mu.Unlock()
+	s.notify()
+}
+
+func (s *prefetchStatus) snapshot() (done, total int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.done, s.total
+}
+
+func (s *prefetchStatus) notify() {
+	select {
+	case s.updates <- struct{}{}:
+	default:
+	}
+}
+
+// Prefetcher is a small worker pool that speculatively warms verseCache
+// while the reader sits on stateReading, so p/n mashing hits the cache
+// instead of blocking on a fetch.
+type Prefetcher struct {
+	provider VerseProvider
+	cache    *verseCache
+	status   *prefetchStatus
+	jobs     chan prefetchJob
+}
+
+func newPrefetcher(provider VerseProvider, cache *verseCache, status *prefetchStatus, poolSize int) *Prefetcher {
+	if poolSize < 1 {
+		poolSize = 1
+	}
+	p := &Prefetcher{provider: provider, cache: cache, status: status, jobs: make(chan prefetchJob, poolSize*4)}
+	for i := 0; i < poolSize; i++ {
+		go p.worker()
+	}
+	return p
+}
+
+func (p *Prefetcher) worker() {
+	for job := range p.jobs {
+		if _, ok := p.cache.get(job.translation, job.reference); !ok {
+			if resp, err := p.provider.FetchVerse(job.reference, job.translation); err == nil {
+				p.cache.put(job.translation, job.reference, resp)
+			}
+		}
+		p.status.increment()
+	}
+}
+
+// enqueue schedules references for background prefetch, skipping anything
+// already cached and silently dropping jobs that don't fit so a backed-up
+// pool never blocks the UI goroutine.
+func (p *Prefetcher) enqueue(translation string, references []string) {
+	queued := 0
+	for _, ref := range references {
+		if _, ok := p.cache.get(translation, ref); ok {
+			continue
+		}
+		select {
+		case p.jobs <- prefetchJob{reference: ref, translation: translation}:
+			queued++
+		default:
+		}
+	}
+	p.status.addTotal(queued)
+}
+
+// prefetchReferences returns up to window single-verse references after
+// (book, chapter, verse), rolling into the next chapter if needed, plus one
+// range reference covering the chapter after that.
+func prefetchReferences(book Book, chapter, verse, window int) []string {
+	var refs []string
+
+	c, v := chapter, verse
+	for i := 0; i < window; i++ {
+		v++
+		if v > verseCount(book.Name, c) {
+			if c >= book.Chapters {
+				break
+			}
+			c++
+			v = 1
+		}
+		refs = append(refs, fmt.Sprintf("%s %d:%d", book.Name, c, v))
+	}
+
+	if chapter < book.Chapters {
+		last := verseCount(book.Name, chapter+1)
+		refs = append(refs, fmt.Sprintf("%s %d:1-%d", book.Name, chapter+1, last))
+	}
+
+	return refs
+}
+
+// prefetchProgressMsg reports the prefetch pool's current done/total split.
+type prefetchProgressMsg struct {
+	done  int
+	total int
+}
+
+// listenPrefetchCmd blocks until the pool reports progress, then re-issues
+// itself so the footer's progress bar keeps receiving updates.
+func listenPrefetchCmd(status *prefetchStatus) tea.Cmd {
+	return func() tea.Msg {
+		<-status.updates
+		done, total := status.snapshot()
+		return prefetchProgressMsg{done: done, total: total}
+	}
+}
+
+func newPrefetchBar() progress.Model {
+	return progress.New(progress.WithDefaultGradient())
+}
+
+// prefetchPercent reports how much of the current prefetch batch is done,
+// reading as "full" once nothing is outstanding.
+func prefetchPercent(done, total int) float64 {
+	if total <= 0 {
+		return 1
+	}
+	return float64(done) / float64(total)
+}
@@ -0,0 +1,341 @@
+package main
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// VerseProvider resolves a reference (e.g. "John 3:16" or "Romans 8:28-30")
+// in a given translation into a BibleResponse. Implementations may be
+// purely local, hit a remote API, or compose both. An empty translation
+// means the provider's default (KJV).
+type VerseProvider interface {
+	FetchVerse(reference, translation string) (BibleResponse, error)
+}
+
+//go:embed data/kjv.json
+var bundledKJV embed.FS
+
+// localProvider answers lookups from the KJV text bundled into the binary:
+// full chapters for Genesis 1, Psalm 1, and Psalm 23, plus the opening verses
+// of John 1, Matthew 5, John 3, Romans 8, and 1 Corinthians 13. This is a
+// curated excerpt (under 100 verses), not the full ~31,000-verse KJV corpus
+// — embedding the whole Bible is future work, tracked separately, not
+// something this change claims to have done. Anything outside the bundled
+// chapters still falls through to the network on first use; see
+// cachingProvider.
+type localProvider struct {
+	books map[string]map[int][]string // book -> chapter -> verse text (1-indexed)
+}
+
+func newLocalProvider() (*localProvider, error) {
+	raw, err := bundledKJV.ReadFile("data/kjv.json")
+	if err != nil {
+		return nil, fmt.Errorf("read bundled kjv data: %w", err)
+	}
+
+	var doc map[string]map[string][]string
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parse bundled kjv data: %w", err)
+	}
+
+	books := make(map[string]map[int][]string, len(doc))
+	for book, chapters := range doc {
+		byChapter := make(map[int][]string, len(chapters))
+		for chapterStr, verses := range chapters {
+			chapter, err := strconv.Atoi(chapterStr)
+			if err != nil {
+				continue
+			}
+			byChapter[chapter] = verses
+		}
+		books[strings.ToLower(book)] = byChapter
+	}
+
+	return &localProvider{books: books}, nil
+}
+
+var refPattern = regexp.MustCompile(`^(.+?)\s+(\d+):(\d+)(?:-(\d+))?$`)
+
+// parseReference splits "Book C:V" or "Book C:V-V2" into its parts.
+func parseReference(reference string) (book string, chapter, startVerse, endVerse int, err error) {
+	match := refPattern.FindStringSubmatch(strings.TrimSpace(reference))
+	if match == nil {
+		return "", 0, 0, 0, fmt.Errorf("unrecognized reference: %q", reference)
+	}
+
+	book = match[1]
+	chapter, _ = strconv.Atoi(match[2])
+	startVerse, _ = strconv.Atoi(match[3])
+	endVerse = startVerse
+	if match[4] != "" {
+		endVerse, _ = strconv.Atoi(match[4])
+	}
+	return book, chapter, startVerse, endVerse, nil
+}
+
+func (p *localProvider) FetchVerse(reference, translation string) (BibleResponse, error) {
+	if translation != "" && !strings.EqualFold(translation, "kjv") {
+		return BibleResponse{}, fmt.Errorf("%s is not bundled locally", translation)
+	}
+
+	book, chapter, startVerse, endVerse, err := parseReference(reference)
+	if err != nil {
+		return BibleResponse{}, err
+	}
+
+	chapters, ok := p.books[strings.ToLower(book)]
+	if !ok {
+		return BibleResponse{}, fmt.Errorf("%s not in bundled KJV data", book)
+	}
+	verses, ok := chapters[chapter]
+	if !ok {
+		return BibleResponse{}, fmt.Errorf("%s %d not in bundled KJV data", book, chapter)
+	}
+	if startVerse < 1 || endVerse > len(verses) || startVerse > endVerse {
+		return BibleResponse{}, fmt.Errorf("%s has no verse range %d-%d", book, startVerse, endVerse)
+	}
+
+	resp := BibleResponse{
+		Translation:     "kjv",
+		TranslationName: "King James Version",
+	}
+	if startVerse == endVerse {
+		resp.Reference = fmt.Sprintf("%s %d:%d", book, chapter, startVerse)
+	} else {
+		resp.Reference = fmt.Sprintf("%s %d:%d-%d", book, chapter, startVerse, endVerse)
+	}
+	for v := startVerse; v <= endVerse; v++ {
+		resp.Verses = append(resp.Verses, struct {
+			BookID   string `json:"book_id"`
+			BookName string `json:"book_name"`
+			Chapter  int    `json:"chapter"`
+			Verse    int    `json:"verse"`
+			Text     string `json:"text"`
+		}{
+			BookName: book,
+			Chapter:  chapter,
+			Verse:    v,
+			Text:     verses[v-1],
+		})
+	}
+	return resp, nil
+}
+
+// indexedVerse is a flattened (book, chapter, verse, text) tuple; it's the
+// unit the full-text search index is built over.
+type indexedVerse struct {
+	book    string
+	chapter int
+	verse   int
+	text    string
+}
+
+// allVerses flattens the bundled KJV text for the search subsystem to
+// index at startup.
+func (p *localProvider) allVerses() []indexedVerse {
+	verses := make([]indexedVerse, 0, 31000)
+	for book, chapters := range p.books {
+		for chapter, vv := range chapters {
+			for i, text := range vv {
+				verses = append(verses, indexedVerse{
+					book:    titleCaseBook(book),
+					chapter: chapter,
+					verse:   i + 1,
+					text:    text,
+				})
+			}
+		}
+	}
+	return verses
+}
+
+// titleCaseBook restores display-friendly casing for a book name that was
+// lowercased as a p.books map key, e.g. "song of solomon" -> "Song Of
+// Solomon".
+func titleCaseBook(name string) string {
+	words := strings.Fields(name)
+	for i, w := range words {
+		if w == "" {
+			continue
+		}
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// remoteProvider fetches verses from bible-api.com, mirroring the request
+// shape the TUI used before the offline cache existed.
+type remoteProvider struct {
+	client *http.Client
+}
+
+func newRemoteProvider() *remoteProvider {
+	return &remoteProvider{client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (p *remoteProvider) FetchVerse(reference, translation string) (BibleResponse, error) {
+	if translation == "" {
+		translation = "kjv"
+	}
+	cleanRef := strings.TrimSpace(reference)
+	encodedRef := url.QueryEscape(cleanRef)
+
+	apiURL := fmt.Sprintf("https://bible-api.com/%s?translation=%s", encodedRef, url.QueryEscape(translation))
+
+	resp, err := p.client.Get(apiURL)
+	if err != nil {
+		return BibleResponse{}, fmt.Errorf("failed to fetch verse: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return BibleResponse{}, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return BibleResponse{}, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var bibleResp BibleResponse
+	if err := json.Unmarshal(body, &bibleResp); err != nil {
+		return BibleResponse{}, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if bibleResp.Reference == "" && bibleResp.Text == "" {
+		return BibleResponse{}, fmt.Errorf("verse not found: %s", reference)
+	}
+
+	return bibleResp, nil
+}
+
+// diskCache persists remote lookups to ~/.cache/bible-tui/cache.json, keyed
+// by (translation, reference), so a reference fetched once stays available
+// offline. It's a JSON blob rewritten in full on every write, not a real
+// database; fine at the size this cache grows to, but each put() pays an
+// O(n) re-marshal of everything seen so far.
+type diskCache struct {
+	mu      sync.Mutex
+	path    string
+	entries map[string]BibleResponse
+}
+
+func cachePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".cache", "bible-tui", "cache.json"), nil
+}
+
+func newDiskCache() (*diskCache, error) {
+	path, err := cachePath()
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &diskCache{path: path, entries: map[string]BibleResponse{}}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cache, nil
+		}
+		return nil, fmt.Errorf("read verse cache: %w", err)
+	}
+	if err := json.Unmarshal(raw, &cache.entries); err != nil {
+		return nil, fmt.Errorf("parse verse cache: %w", err)
+	}
+	return cache, nil
+}
+
+func cacheKey(translation, reference string) string {
+	if translation == "" {
+		translation = "kjv"
+	}
+	return strings.ToLower(translation) + "|" + strings.ToLower(reference)
+}
+
+func (c *diskCache) get(reference, translation string) (BibleResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resp, ok := c.entries[cacheKey(translation, reference)]
+	return resp, ok
+}
+
+// put is called from every prefetch worker goroutine as well as the main
+// fetch path, so entries/disk writes are serialized under mu: the pool's
+// whole point is concurrent lookups, and a plain map doesn't survive
+// concurrent writers.
+func (c *diskCache) put(reference, translation string, resp BibleResponse) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[cacheKey(translation, reference)] = resp
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("create cache dir: %w", err)
+	}
+	raw, err := json.Marshal(c.entries)
+	if err != nil {
+		return fmt.Errorf("marshal verse cache: %w", err)
+	}
+	if err := os.WriteFile(c.path, raw, 0o644); err != nil {
+		return fmt.Errorf("write verse cache: %w", err)
+	}
+	return nil
+}
+
+// cachingProvider tries the bundled KJV data, then the on-disk cache of
+// past remote lookups, and only falls back to the network as a last
+// resort, caching whatever it fetches for next time.
+type cachingProvider struct {
+	local  *localProvider
+	remote *remoteProvider
+	disk   *diskCache
+}
+
+func newCachingProvider() (*cachingProvider, error) {
+	local, err := newLocalProvider()
+	if err != nil {
+		return nil, err
+	}
+	disk, err := newDiskCache()
+	if err != nil {
+		return nil, err
+	}
+	return &cachingProvider{local: local, remote: newRemoteProvider(), disk: disk}, nil
+}
+
+func (p *cachingProvider) FetchVerse(reference, translation string) (BibleResponse, error) {
+	if resp, err := p.local.FetchVerse(reference, translation); err == nil {
+		return resp, nil
+	}
+
+	if resp, ok := p.disk.get(reference, translation); ok {
+		return resp, nil
+	}
+
+	resp, err := p.remote.FetchVerse(reference, translation)
+	if err != nil {
+		return BibleResponse{}, err
+	}
+
+	if err := p.disk.put(reference, translation, resp); err != nil {
+		// A failed cache write shouldn't stop the verse from being shown.
+		return resp, nil
+	}
+	return resp, nil
+}
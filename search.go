@@ -0,0 +1,457 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxSearchResults caps the ranked hit list so a common word doesn't dump
+// thousands of matches into the results view.
+const maxSearchResults = 50
+
+// verseID identifies a single verse for indexing and navigation purposes.
+type verseID struct {
+	book    string
+	chapter int
+	verse   int
+}
+
+// Reference renders the ID the same way navigateVerse/handleSelect build
+// references, so it round-trips through parseReference.
+func (id verseID) Reference() string {
+	return fmt.Sprintf("%s %d:%d", id.book, id.chapter, id.verse)
+}
+
+// stopWords are dropped during tokenization; they carry no search signal
+// and would otherwise dominate postings lists.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "for": true, "from": true, "had": true, "has": true,
+	"he": true, "in": true, "is": true, "it": true, "its": true, "of": true,
+	"on": true, "shall": true, "that": true, "the": true, "their": true,
+	"they": true, "this": true, "to": true, "was": true, "were": true,
+	"will": true, "with": true,
+}
+
+// stem is a simplified Porter-style suffix stripper: enough to fold common
+// verb/plural endings together without pulling in a full dependency.
+func stem(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ing") && len(word) > 5:
+		return word[:len(word)-3]
+	case strings.HasSuffix(word, "edly") && len(word) > 6:
+		return word[:len(word)-4]
+	case strings.HasSuffix(word, "ed") && len(word) > 4:
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "ly") && len(word) > 4:
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "ies") && len(word) > 4:
+		return word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "es") && len(word) > 4:
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && len(word) > 3:
+		return word[:len(word)-1]
+	default:
+		return word
+	}
+}
+
+// tokenize lowercases, splits on non-letters, drops stop words, and stems
+// what's left.
+func tokenize(text string) []string {
+	var tokens []string
+	var cur strings.Builder
+
+	flush := func() {
+		if cur.Len() == 0 {
+			return
+		}
+		word := strings.ToLower(cur.String())
+		cur.Reset()
+		if stopWords[word] {
+			return
+		}
+		tokens = append(tokens, stem(word))
+	}
+
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			cur.WriteRune(r)
+		} else {
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// posting is one (verse, term frequency) entry in a token's postings list.
+type posting struct {
+	id verseID
+	tf int
+}
+
+// searchIndex is an in-memory inverted index over the bundled verse text,
+// built once at startup.
+type searchIndex struct {
+	postings  map[string][]posting
+	texts     map[verseID]string
+	totalDocs int
+}
+
+// buildSearchIndex indexes every verse's tokens. A nil/empty input yields
+// an index that matches nothing, which is the graceful degradation when no
+// local provider was available to supply verses.
+func buildSearchIndex(verses []indexedVerse) *searchIndex {
+	idx := &searchIndex{postings: map[string][]posting{}, texts: map[verseID]string{}}
+
+	for _, v := range verses {
+		id := verseID{book: v.book, chapter: v.chapter, verse: v.verse}
+		idx.texts[id] = v.text
+		idx.totalDocs++
+
+		counts := map[string]int{}
+		for _, tok := range tokenize(v.text) {
+			counts[tok]++
+		}
+		for tok, tf := range counts {
+			idx.postings[tok] = append(idx.postings[tok], posting{id: id, tf: tf})
+		}
+	}
+	return idx
+}
+
+// idf is the standard inverse document frequency, smoothed so an unseen
+// term scores zero instead of dividing by zero.
+func (idx *searchIndex) idf(term string) float64 {
+	df := len(idx.postings[term])
+	if df == 0 {
+		return 0
+	}
+	return math.Log(float64(idx.totalDocs+1) / float64(df))
+}
+
+func (idx *searchIndex) tfidf(id verseID, terms []string) float64 {
+	var score float64
+	for _, term := range terms {
+		for _, p := range idx.postings[term] {
+			if p.id == id {
+				score += float64(p.tf) * idx.idf(term)
+				break
+			}
+		}
+	}
+	return score
+}
+
+// containsPhrase checks whether text's tokenized form contains phrase as a
+// contiguous run, after the same stop-word/stemming normalization used to
+// build the index.
+func containsPhrase(text string, phrase []string) bool {
+	if len(phrase) == 0 {
+		return false
+	}
+	tokens := tokenize(text)
+	for i := 0; i+len(phrase) <= len(tokens); i++ {
+		match := true
+		for j, term := range phrase {
+			if tokens[i+j] != term {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// searchQuery is a parsed `:search`/stateSearch query: terms are ANDed,
+// phrases must match verbatim (after normalization), and mustNot terms
+// exclude a verse outright.
+type searchQuery struct {
+	must    []string
+	mustNot []string
+	phrases [][]string
+}
+
+// splitQueryTerms splits on whitespace but keeps "quoted phrases" intact.
+func splitQueryTerms(raw string) []string {
+	var terms []string
+	var cur strings.Builder
+	inQuote := false
+
+	for _, r := range raw {
+		switch {
+		case r == '"':
+			cur.WriteRune(r)
+			inQuote = !inQuote
+		case r == ' ' && !inQuote:
+			if cur.Len() > 0 {
+				terms = append(terms, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if cur.Len() > 0 {
+		terms = append(terms, cur.String())
+	}
+	return terms
+}
+
+// parseSearchQuery turns raw input like `love AND god -death "kingdom of
+// heaven"` into a searchQuery. AND is implicit between bare terms; the
+// keyword is accepted but doesn't change anything.
+func parseSearchQuery(raw string) searchQuery {
+	var q searchQuery
+
+	for _, term := range splitQueryTerms(raw) {
+		switch {
+		case strings.HasPrefix(term, `"`):
+			phrase := strings.Trim(term, `"`)
+			if toks := tokenize(phrase); len(toks) > 0 {
+				q.phrases = append(q.phrases, toks)
+			}
+		case strings.EqualFold(term, "and"):
+			// Terms are ANDed by default; nothing to record.
+		case strings.HasPrefix(term, "-") && len(term) > 1:
+			if toks := tokenize(term[1:]); len(toks) > 0 {
+				q.mustNot = append(q.mustNot, toks[0])
+			}
+		default:
+			q.must = append(q.must, tokenize(term)...)
+		}
+	}
+	return q
+}
+
+// searchHit is one ranked result.
+type searchHit struct {
+	id    verseID
+	score float64
+}
+
+// search resolves a query against the index: must-terms and phrases are
+// ANDed together, mustNot terms exclude a verse regardless of how well it
+// otherwise scores.
+func (idx *searchIndex) search(q searchQuery) []searchHit {
+	if len(q.must) == 0 && len(q.phrases) == 0 {
+		return nil
+	}
+
+	var docs map[verseID]bool
+	intersect := func(matched map[verseID]bool) {
+		if docs == nil {
+			docs = matched
+			return
+		}
+		for id := range docs {
+			if !matched[id] {
+				delete(docs, id)
+			}
+		}
+	}
+
+	for _, term := range q.must {
+		matched := map[verseID]bool{}
+		for _, p := range idx.postings[term] {
+			matched[p.id] = true
+		}
+		intersect(matched)
+	}
+	for _, phrase := range q.phrases {
+		matched := map[verseID]bool{}
+		for id, text := range idx.texts {
+			if containsPhrase(text, phrase) {
+				matched[id] = true
+			}
+		}
+		intersect(matched)
+	}
+
+	for _, neg := range q.mustNot {
+		for _, p := range idx.postings[neg] {
+			delete(docs, p.id)
+		}
+	}
+
+	hits := make([]searchHit, 0, len(docs))
+	for id := range docs {
+		score := idx.tfidf(id, q.must) + float64(len(q.phrases))*2
+		hits = append(hits, searchHit{id: id, score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score != hits[j].score {
+			return hits[i].score > hits[j].score
+		}
+		return hits[i].id.Reference() < hits[j].id.Reference()
+	})
+	if len(hits) > maxSearchResults {
+		hits = hits[:maxSearchResults]
+	}
+	return hits
+}
+
+// searchHighlightStyle marks query terms inside a result snippet.
+var searchHighlightStyle = selectedStyle
+
+// snippet renders text with every word matching a query term (must terms
+// or words from any phrase) highlighted, truncating to width.
+func snippet(text string, q searchQuery, width int) string {
+	terms := map[string]bool{}
+	for _, t := range q.must {
+		terms[t] = true
+	}
+	for _, phrase := range q.phrases {
+		for _, t := range phrase {
+			terms[t] = true
+		}
+	}
+
+	words := strings.Fields(text)
+	var b strings.Builder
+	for i, w := range words {
+		bare := strings.Trim(w, ".,;:!?\"'")
+		if terms[stem(strings.ToLower(bare))] {
+			b.WriteString(searchHighlightStyle.Render(w))
+		} else {
+			b.WriteString(w)
+		}
+		if i < len(words)-1 {
+			b.WriteString(" ")
+		}
+	}
+
+	out := b.String()
+	if width > 0 && len(out) > width {
+		out = out[:width-3] + "..."
+	}
+	return out
+}
+
+// searchHitItem adapts a searchHit for use in a list.Model.
+type searchHitItem struct {
+	hit  searchHit
+	text string
+	q    searchQuery
+}
+
+func (i searchHitItem) Title() string       { return i.hit.id.Reference() }
+func (i searchHitItem) Description() string { return snippet(i.text, i.q, 100) }
+func (i searchHitItem) FilterValue() string { return i.hit.id.Reference() }
+
+// createSearchResultsList renders ranked hits, highest score first.
+func createSearchResultsList(hits []searchHit, idx *searchIndex, q searchQuery) list.Model {
+	items := make([]list.Item, len(hits))
+	for i, hit := range hits {
+		items[i] = searchHitItem{hit: hit, text: idx.texts[hit.id], q: q}
+	}
+
+	l := list.New(items, list.NewDefaultDelegate(), 80, 20)
+	l.Title = "Search Results"
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(false)
+	return l
+}
+
+func newSearchInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "/"
+	ti.CharLimit = 120
+	ti.Placeholder = `love AND god, "kingdom of heaven", -death`
+	ti.Focus()
+	return ti
+}
+
+// enterSearchMode switches into stateSearch with a freshly focused query
+// box, remembering where to return to on cancel.
+func (m model) enterSearchMode() (tea.Model, tea.Cmd) {
+	if m.loading {
+		return m, nil
+	}
+	m.previousState = m.state
+	m.state = stateSearch
+	m.searchResultsShown = false
+	m.searchInput = newSearchInput()
+	m.err = nil
+	return m, textinput.Blink
+}
+
+// handleSearchKey drives the query box; enter runs the search and swaps
+// m.list for the ranked results, esc cancels back to where we came from.
+func (m model) handleSearchKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.state = m.previousState
+		m.searchInput.Blur()
+		return m, nil
+	case "enter":
+		raw := m.searchInput.Value()
+		m.searchInput.Blur()
+		return m.runSearch(raw)
+	}
+
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+	return m, cmd
+}
+
+// runSearch executes a query against the index and shows the ranked
+// results as a list.Model in place of the query box.
+func (m model) runSearch(raw string) (tea.Model, tea.Cmd) {
+	q := parseSearchQuery(raw)
+	hits := m.searchIndex.search(q)
+
+	m.state = stateSearch
+	m.searchQuery = q
+	m.list = createSearchResultsList(hits, m.searchIndex, q)
+	m.searchResultsShown = true
+
+	if len(hits) == 0 {
+		m.err = fmt.Errorf("no matches for %q", raw)
+	} else {
+		m.err = nil
+	}
+	return m, nil
+}
+
+// jumpToSearchHit navigates straight into stateReading for the result
+// under the cursor, with the breadcrumb pre-populated as if the user had
+// walked the testament/book/chapter/verse tree there directly.
+func (m model) jumpToSearchHit() (tea.Model, tea.Cmd) {
+	selected, ok := m.list.SelectedItem().(searchHitItem)
+	if !ok {
+		return m, nil
+	}
+
+	id := selected.hit.id
+	selectedBook, testament, ok := findBookByName(id.book)
+	if !ok {
+		selectedBook = Book{Name: id.book}
+	}
+	m.selectedBook = selectedBook
+	m.selectedTest = testament
+	m.selectedChap = id.chapter
+	m.selectedVerse = id.verse
+	m.breadcrumb = []string{id.book, fmt.Sprintf("Chapter %d", id.chapter), fmt.Sprintf("Verse %d", id.verse)}
+	if ok {
+		m.breadcrumb = append([]string{testament.Name}, m.breadcrumb...)
+	}
+	m.loading = true
+	m.err = nil
+
+	return m, tea.Batch(
+		m.spinner.Tick,
+		fetchVerseCmd(m.currentTranslation(), id.Reference()),
+	)
+}
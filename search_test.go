@@ -0,0 +1,151 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "drops stop words and stems plurals",
+			text: "The kings of the earth",
+			want: []string{"king", "earth"},
+		},
+		{
+			name: "lowercases and splits on punctuation",
+			text: "Love, joy; peace!",
+			want: []string{"love", "joy", "peace"},
+		},
+		{
+			name: "ing suffix stripped",
+			text: "believing",
+			want: []string{"believ"},
+		},
+		{
+			name: "empty string yields no tokens",
+			text: "",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tokenize(tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tokenize(%q) = %#v, want %#v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStem(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"believing", "believ"},
+		{"blessed", "bless"},
+		{"repeatedly", "repeat"},
+		{"kings", "king"},
+		{"glories", "glory"},
+		{"witnesses", "witness"},
+		{"glass", "glass"}, // double-s guard: not treated as plural
+		{"god", "god"},     // no suffix to strip
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := stem(tt.word); got != tt.want {
+				t.Errorf("stem(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainsPhrase(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		phrase []string
+		want   bool
+	}{
+		{
+			name:   "contiguous match after normalization",
+			text:   "the kingdom of heaven is at hand",
+			phrase: []string{"kingdom", "heaven"},
+			want:   true,
+		},
+		{
+			name:   "terms present but in reverse order",
+			text:   "heaven and the kingdom",
+			phrase: []string{"kingdom", "heaven"},
+			want:   false,
+		},
+		{
+			name:   "empty phrase never matches",
+			text:   "kingdom of heaven",
+			phrase: nil,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := containsPhrase(tt.text, tt.phrase); got != tt.want {
+				t.Errorf("containsPhrase(%q, %v) = %v, want %v", tt.text, tt.phrase, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want searchQuery
+	}{
+		{
+			name: "bare terms are ANDed",
+			raw:  "love god",
+			want: searchQuery{must: []string{"love", "god"}},
+		},
+		{
+			name: "explicit and keyword is a no-op",
+			raw:  "love AND god",
+			want: searchQuery{must: []string{"love", "god"}},
+		},
+		{
+			name: "minus prefix excludes a term",
+			raw:  "love -death",
+			want: searchQuery{must: []string{"love"}, mustNot: []string{"death"}},
+		},
+		{
+			name: "quoted phrase kept intact and tokenized",
+			raw:  `"kingdom of heaven"`,
+			want: searchQuery{phrases: [][]string{{"kingdom", "heaven"}}},
+		},
+		{
+			name: "mixed terms, exclusion, and phrase",
+			raw:  `faith -works "good works"`,
+			want: searchQuery{
+				must:    []string{"faith"},
+				mustNot: []string{"work"},
+				phrases: [][]string{{"good", "work"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseSearchQuery(tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseSearchQuery(%q) = %#v, want %#v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Bookmark is a user-saved reference with an optional note, managed from
+// the sessions view.
+type Bookmark struct {
+	Translation string    `json:"translation"`
+	Reference   string    `json:"reference"`
+	Timestamp   time.Time `json:"timestamp"`
+	Note        string    `json:"note"`
+}
+
+// Highlight is a user-marked verse worth revisiting — a lighter-weight
+// "favorite" than a Bookmark, toggled with a single key from stateReading
+// rather than explicitly created/named from the sessions view.
+type Highlight struct {
+	Translation string    `json:"translation"`
+	Reference   string    `json:"reference"`
+	Timestamp   time.Time `json:"timestamp"`
+	Note        string    `json:"note"`
+}
+
+// HistoryEntry is an automatically recorded visit to a verse, so a reader
+// can resume where they left off without re-walking the nav tree.
+type HistoryEntry struct {
+	Translation string    `json:"translation"`
+	Reference   string    `json:"reference"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// sessionData is the on-disk shape of the sessions store.
+type sessionData struct {
+	Bookmarks  []Bookmark     `json:"bookmarks"`
+	Highlights []Highlight    `json:"highlights"`
+	History    []HistoryEntry `json:"history"`
+}
+
+const maxHistoryEntries = 200
+
+// sessionStore persists bookmarks and history next to the verse cache.
+type sessionStore struct {
+	path string
+}
+
+func sessionsPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".cache", "bible-tui", "sessions.json"), nil
+}
+
+func newSessionStore() (*sessionStore, error) {
+	path, err := sessionsPath()
+	if err != nil {
+		return nil, err
+	}
+	return &sessionStore{path: path}, nil
+}
+
+func (s *sessionStore) load() (sessionData, error) {
+	var data sessionData
+
+	raw, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return data, nil
+		}
+		return data, fmt.Errorf("read sessions: %w", err)
+	}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return data, fmt.Errorf("parse sessions: %w", err)
+	}
+	return data, nil
+}
+
+func (s *sessionStore) save(data sessionData) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return fmt.Errorf("create sessions dir: %w", err)
+	}
+	raw, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal sessions: %w", err)
+	}
+	if err := os.WriteFile(s.path, raw, 0o644); err != nil {
+		return fmt.Errorf("write sessions: %w", err)
+	}
+	return nil
+}
+
+// sessionsSaveMsg reports the outcome of a background save.
+type sessionsSaveMsg struct{ err error }
+
+func (m model) saveSessionsCmd() tea.Cmd {
+	return func() tea.Msg {
+		if m.sessions == nil {
+			return nil
+		}
+		err := m.sessions.save(sessionData{Bookmarks: m.bookmarks, Highlights: m.highlights, History: m.history})
+		return sessionsSaveMsg{err: err}
+	}
+}
+
+// loadSessionsCmd reads the persisted bookmarks, highlights, and history
+// during Init.
+func loadSessionsCmd(store *sessionStore) tea.Cmd {
+	return func() tea.Msg {
+		if store == nil {
+			return sessionsLoadedMsg{}
+		}
+		data, err := store.load()
+		if err != nil {
+			return errMsg(err)
+		}
+		return sessionsLoadedMsg{bookmarks: data.Bookmarks, highlights: data.Highlights, history: data.History}
+	}
+}
+
+type sessionsLoadedMsg struct {
+	bookmarks  []Bookmark
+	highlights []Highlight
+	history    []HistoryEntry
+}
+
+// recordHistory appends a visit, trimming to the most recent entries.
+func recordHistory(history []HistoryEntry, translation, reference string) []HistoryEntry {
+	history = append(history, HistoryEntry{
+		Translation: translation,
+		Reference:   reference,
+		Timestamp:   timeNow(),
+	})
+	if len(history) > maxHistoryEntries {
+		history = history[len(history)-maxHistoryEntries:]
+	}
+	return history
+}
+
+// timeNow exists so the one non-deterministic call in this file is easy
+// to spot and, if ever needed, to stub.
+func timeNow() time.Time { return time.Now() }
+
+// sessionItem adapts a Bookmark, Highlight, or HistoryEntry for use in a
+// list.Model.
+type sessionItem struct {
+	kind  string // "bookmark", "highlight", or "history"
+	entry Bookmark
+}
+
+func (i sessionItem) Title() string {
+	switch i.kind {
+	case "bookmark":
+		return "★ " + i.entry.Reference
+	case "highlight":
+		return "✦ " + i.entry.Reference
+	default:
+		return "  " + i.entry.Reference
+	}
+}
+
+func (i sessionItem) Description() string {
+	desc := fmt.Sprintf("%s • %s", i.entry.Translation, i.entry.Timestamp.Format("2006-01-02 15:04"))
+	if i.entry.Note != "" {
+		desc = i.entry.Note + " — " + desc
+	}
+	return desc
+}
+
+func (i sessionItem) FilterValue() string { return i.entry.Reference }
+
+// createSessionsList renders bookmarks, then highlights, then history,
+// newest first within each group.
+func createSessionsList(bookmarks []Bookmark, highlights []Highlight, history []HistoryEntry) list.Model {
+	items := make([]list.Item, 0, len(bookmarks)+len(highlights)+len(history))
+	for i := len(bookmarks) - 1; i >= 0; i-- {
+		items = append(items, sessionItem{kind: "bookmark", entry: bookmarks[i]})
+	}
+	for i := len(highlights) - 1; i >= 0; i-- {
+		items = append(items, sessionItem{kind: "highlight", entry: Bookmark{
+			Translation: highlights[i].Translation,
+			Reference:   highlights[i].Reference,
+			Timestamp:   highlights[i].Timestamp,
+			Note:        highlights[i].Note,
+		}})
+	}
+	for i := len(history) - 1; i >= 0; i-- {
+		items = append(items, sessionItem{kind: "history", entry: Bookmark{
+			Translation: history[i].Translation,
+			Reference:   history[i].Reference,
+			Timestamp:   history[i].Timestamp,
+		}})
+	}
+
+	l := list.New(items, list.NewDefaultDelegate(), 80, 20)
+	l.Title = "Sessions: bookmarks, highlights & history"
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	return l
+}
+
+// jumpToSelectedSession navigates straight into stateReading for the
+// bookmark or history entry under the cursor.
+func (m model) jumpToSelectedSession() (tea.Model, tea.Cmd) {
+	selected, ok := m.list.SelectedItem().(sessionItem)
+	if !ok {
+		return m, nil
+	}
+
+	book, chapter, verse, _, err := parseReference(selected.entry.Reference)
+	if err != nil {
+		m.err = err
+		return m, nil
+	}
+
+	selectedBook, testament, ok := findBookByName(book)
+	if !ok {
+		selectedBook = Book{Name: book}
+	}
+	m.selectedBook = selectedBook
+	m.selectedTest = testament
+	m.selectedChap = chapter
+	m.selectedVerse = verse
+	m.breadcrumb = []string{book, fmt.Sprintf("Chapter %d", chapter), fmt.Sprintf("Verse %d", verse)}
+	m.loading = true
+	m.err = nil
+
+	return m, tea.Batch(
+		m.spinner.Tick,
+		fetchVerseCmd(m.currentTranslation(), selected.entry.Reference),
+	)
+}
+
+// newBookmark saves the last-viewed reference as a bookmark.
+func (m model) newBookmark() (tea.Model, tea.Cmd) {
+	if m.lastReference == "" {
+		return m, nil
+	}
+
+	m.bookmarks = append(m.bookmarks, Bookmark{
+		Translation: m.lastTranslation,
+		Reference:   m.lastReference,
+		Timestamp:   timeNow(),
+	})
+	m.list = createSessionsList(m.bookmarks, m.highlights, m.history)
+	return m, m.saveSessionsCmd()
+}
+
+// toggleHighlight marks the last-viewed reference as highlighted, or
+// un-highlights it if it already is — a one-key "favorite" toggle from
+// stateReading, unlike a bookmark which is explicit and named.
+func (m model) toggleHighlight() (tea.Model, tea.Cmd) {
+	if m.lastReference == "" {
+		return m, nil
+	}
+
+	for i, h := range m.highlights {
+		if h.Reference == m.lastReference && h.Translation == m.lastTranslation {
+			m.highlights = append(m.highlights[:i], m.highlights[i+1:]...)
+			return m, m.saveSessionsCmd()
+		}
+	}
+
+	m.highlights = append(m.highlights, Highlight{
+		Translation: m.lastTranslation,
+		Reference:   m.lastReference,
+		Timestamp:   timeNow(),
+	})
+	return m, m.saveSessionsCmd()
+}
+
+// deleteSelectedEntry removes the bookmark or highlight under the cursor;
+// history entries are read-only and ignored.
+func (m model) deleteSelectedEntry() (tea.Model, tea.Cmd) {
+	selected, ok := m.list.SelectedItem().(sessionItem)
+	if !ok {
+		return m, nil
+	}
+
+	switch selected.kind {
+	case "bookmark":
+		for i, b := range m.bookmarks {
+			if b.Reference == selected.entry.Reference && b.Timestamp.Equal(selected.entry.Timestamp) {
+				m.bookmarks = append(m.bookmarks[:i], m.bookmarks[i+1:]...)
+				break
+			}
+		}
+	case "highlight":
+		for i, h := range m.highlights {
+			if h.Reference == selected.entry.Reference && h.Timestamp.Equal(selected.entry.Timestamp) {
+				m.highlights = append(m.highlights[:i], m.highlights[i+1:]...)
+				break
+			}
+		}
+	default:
+		return m, nil
+	}
+
+	m.list = createSessionsList(m.bookmarks, m.highlights, m.history)
+	return m, m.saveSessionsCmd()
+}
+
+// beginRename starts editing the note on the bookmark or highlight under
+// the cursor.
+func (m model) beginRename() (tea.Model, tea.Cmd) {
+	selected, ok := m.list.SelectedItem().(sessionItem)
+	if !ok || (selected.kind != "bookmark" && selected.kind != "highlight") {
+		return m, nil
+	}
+	m.renaming = true
+	m.renameBuffer = selected.entry.Note
+	return m, nil
+}
+
+// handleRenameKey feeds keystrokes into the in-progress note edit until
+// the user confirms with enter or cancels with esc.
+func (m model) handleRenameKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		selected, ok := m.list.SelectedItem().(sessionItem)
+		if ok {
+			switch selected.kind {
+			case "bookmark":
+				for i, b := range m.bookmarks {
+					if b.Reference == selected.entry.Reference && b.Timestamp.Equal(selected.entry.Timestamp) {
+						m.bookmarks[i].Note = m.renameBuffer
+						break
+					}
+				}
+			case "highlight":
+				for i, h := range m.highlights {
+					if h.Reference == selected.entry.Reference && h.Timestamp.Equal(selected.entry.Timestamp) {
+						m.highlights[i].Note = m.renameBuffer
+						break
+					}
+				}
+			}
+			m.list = createSessionsList(m.bookmarks, m.highlights, m.history)
+		}
+		m.renaming = false
+		m.renameBuffer = ""
+		return m, m.saveSessionsCmd()
+	case "esc":
+		m.renaming = false
+		m.renameBuffer = ""
+		return m, nil
+	case "backspace":
+		if len(m.renameBuffer) > 0 {
+			m.renameBuffer = m.renameBuffer[:len(m.renameBuffer)-1]
+		}
+		return m, nil
+	default:
+		if len(msg.Runes) > 0 {
+			m.renameBuffer += string(msg.Runes)
+		}
+		return m, nil
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Translation is one entry in the translation registry: enough metadata
+// to list and persist it, plus a Fetch func bound to a VerseProvider so
+// callers don't need to thread the translation ID through every call.
+type Translation struct {
+	ID       string
+	Name     string
+	Language string
+	Source   string
+	Fetch    func(reference string) (BibleResponse, error)
+}
+
+// translationMeta is the static catalogue; buildTranslations binds each
+// entry's Fetch func to a concrete provider.
+var translationMeta = []Translation{
+	{ID: "kjv", Name: "King James Version", Language: "English", Source: "bundled excerpt + bible-api.com"},
+	{ID: "web", Name: "World English Bible", Language: "English", Source: "bible-api.com"},
+	{ID: "asv", Name: "American Standard Version", Language: "English", Source: "bible-api.com"},
+	{ID: "bbe", Name: "Bible in Basic English", Language: "English", Source: "bible-api.com"},
+	{ID: "avd", Name: "Van Dyck", Language: "Arabic", Source: "bible-api.com"},
+	{ID: "urd-geo", Name: "Urdu Geo Version", Language: "Urdu", Source: "bible-api.com"},
+}
+
+// buildTranslations binds translationMeta to a provider so each entry's
+// Fetch closes over its own ID.
+func buildTranslations(provider VerseProvider) []Translation {
+	translations := make([]Translation, len(translationMeta))
+	for i, t := range translationMeta {
+		id := t.ID
+		t.Fetch = func(reference string) (BibleResponse, error) {
+			return provider.FetchVerse(reference, id)
+		}
+		translations[i] = t
+	}
+	return translations
+}
+
+func translationByID(translations []Translation, id string) (Translation, bool) {
+	for _, t := range translations {
+		if strings.EqualFold(t.ID, id) {
+			return t, true
+		}
+	}
+	return Translation{}, false
+}
+
+// currentTranslation returns the active Translation, falling back to the
+// first registered one (KJV) if activeTranslation is unset or unknown.
+func (m model) currentTranslation() Translation {
+	if t, ok := translationByID(m.translations, m.activeTranslation); ok {
+		return t
+	}
+	return m.translations[0]
+}
+
+func (i Translation) Title() string       { return fmt.Sprintf("%s (%s)", i.Name, strings.ToUpper(i.ID)) }
+func (i Translation) Description() string { return i.Language + " • " + i.Source }
+func (i Translation) FilterValue() string { return i.Name }
+
+func createTranslationList(translations []Translation) list.Model {
+	items := make([]list.Item, len(translations))
+	for i, t := range translations {
+		items[i] = t
+	}
+
+	l := list.New(items, list.NewDefaultDelegate(), 80, 20)
+	l.Title = "Select Translation"
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	return l
+}
+
+// config is the on-disk shape of persisted user settings: the active
+// translation, plus the prefetch pool size and window — tunable the same
+// way, instead of the fixed defaultPrefetchPoolSize/defaultPrefetchWindow
+// constants. Zero means "unset, use the default".
+type config struct {
+	ActiveTranslation string `json:"active_translation"`
+	PrefetchPoolSize  int    `json:"prefetch_pool_size,omitempty"`
+	PrefetchWindow    int    `json:"prefetch_window,omitempty"`
+}
+
+func configPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "bible-tui", "config.json"), nil
+}
+
+func loadConfig() (config, error) {
+	path, err := configPath()
+	if err != nil {
+		return config{}, err
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return config{}, nil
+		}
+		return config{}, fmt.Errorf("read config: %w", err)
+	}
+
+	var cfg config
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return config{}, fmt.Errorf("parse config: %w", err)
+	}
+	return cfg, nil
+}
+
+func saveConfig(cfg config) error {
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+
+	raw, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal config: %w", err)
+	}
+	if err := os.WriteFile(path, raw, 0o644); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+	return nil
+}
+
+// loadTranslationConfigCmd reads the persisted translation choice during Init.
+func loadTranslationConfigCmd() tea.Cmd {
+	return func() tea.Msg {
+		cfg, err := loadConfig()
+		if err != nil {
+			return errMsg(err)
+		}
+		return translationConfigLoadedMsg{id: cfg.ActiveTranslation}
+	}
+}
+
+type translationConfigLoadedMsg struct{ id string }
+
+// saveTranslationConfigCmd persists the active translation in the
+// background, preserving whatever prefetch settings are already on disk.
+func saveTranslationConfigCmd(translation string) tea.Cmd {
+	return func() tea.Msg {
+		cfg, err := loadConfig()
+		if err != nil {
+			return errMsg(err)
+		}
+		cfg.ActiveTranslation = translation
+		if err := saveConfig(cfg); err != nil {
+			return errMsg(err)
+		}
+		return nil
+	}
+}
+
+// enterTranslationMode switches into stateTranslation with the registry
+// list, remembering where to return to on cancel.
+func (m model) enterTranslationMode() (tea.Model, tea.Cmd) {
+	if m.loading {
+		return m, nil
+	}
+	m.previousState = m.state
+	m.state = stateTranslation
+	m.list = createTranslationList(m.translations)
+	m.err = nil
+	return m, nil
+}
+
+// selectTranslation activates the translation under the cursor in the
+// stateTranslation list, persists it, and returns to where the user was.
+func (m model) selectTranslation() (tea.Model, tea.Cmd) {
+	selected, ok := m.list.SelectedItem().(Translation)
+	if !ok {
+		return m, nil
+	}
+
+	m.activeTranslation = selected.ID
+	m.list = m.restoreListForState(m.previousState)
+	m.state = m.previousState
+	m.err = nil
+	return m, saveTranslationConfigCmd(selected.ID)
+}